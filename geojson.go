@@ -0,0 +1,244 @@
+package h3
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Feature pairs a decoded GeoPolygon with the free-form properties carried
+// alongside it in a GeoJSON Feature object.
+type Feature struct {
+	Polygon    *GeoPolygon
+	Properties map[string]string
+}
+
+// FeatureCollection is the decoded form of a GeoJSON FeatureCollection: an
+// ordered list of Features, each with its own polygon and properties.
+type FeatureCollection struct {
+	Features []Feature
+}
+
+// geoJSONDoc is the wire shape shared by Polygon, MultiPolygon, Feature and
+// FeatureCollection documents; which fields are populated depends on Type.
+type geoJSONDoc struct {
+	Type        string            `json:"type"`
+	Coordinates json.RawMessage   `json:"coordinates,omitempty"`
+	Geometry    *geoJSONDoc       `json:"geometry,omitempty"`
+	Properties  map[string]string `json:"properties,omitempty"`
+	Features    []geoJSONDoc      `json:"features,omitempty"`
+}
+
+// ParseGeoJSON decodes a GeoJSON Polygon, MultiPolygon, Feature or
+// FeatureCollection document into a FeatureCollection. Polygon documents are
+// wrapped in a single Feature with empty Properties; a MultiPolygon is
+// fanned out into one Feature per member polygon, all sharing the same
+// Properties, since GeoPolygon (and Polyfill) model a single ring set per
+// call. Longitude/latitude degree pairs are converted into the internal
+// radian geoCoord representation as they are decoded.
+func ParseGeoJSON(r io.Reader) (*FeatureCollection, error) {
+	var doc geoJSONDoc
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("h3: decoding GeoJSON: %w", err)
+	}
+
+	switch doc.Type {
+	case "FeatureCollection":
+		fc := &FeatureCollection{}
+		for i := range doc.Features {
+			fs, err := decodeFeature(&doc.Features[i])
+			if err != nil {
+				return nil, fmt.Errorf("h3: decoding feature %d: %w", i, err)
+			}
+			fc.Features = append(fc.Features, fs...)
+		}
+		return fc, nil
+
+	case "Feature":
+		fs, err := decodeFeature(&doc)
+		if err != nil {
+			return nil, err
+		}
+		return &FeatureCollection{Features: fs}, nil
+
+	case "Polygon", "MultiPolygon":
+		polygons, err := decodeGeometry(&doc)
+		if err != nil {
+			return nil, err
+		}
+		fc := &FeatureCollection{Features: make([]Feature, len(polygons))}
+		for i, p := range polygons {
+			fc.Features[i] = Feature{Polygon: p}
+		}
+		return fc, nil
+
+	default:
+		return nil, fmt.Errorf("h3: unsupported GeoJSON type %q", doc.Type)
+	}
+}
+
+func decodeFeature(doc *geoJSONDoc) ([]Feature, error) {
+	if doc.Geometry == nil {
+		return nil, fmt.Errorf("h3: feature has no geometry")
+	}
+
+	polygons, err := decodeGeometry(doc.Geometry)
+	if err != nil {
+		return nil, err
+	}
+
+	features := make([]Feature, len(polygons))
+	for i, p := range polygons {
+		features[i] = Feature{Polygon: p, Properties: doc.Properties}
+	}
+	return features, nil
+}
+
+// decodeGeometry decodes a Polygon or MultiPolygon geometry into one
+// GeoPolygon per member: a Polygon always yields exactly one, a
+// MultiPolygon yields one per polygon it contains, each with its own
+// exterior ring and holes.
+func decodeGeometry(doc *geoJSONDoc) ([]*GeoPolygon, error) {
+	switch doc.Type {
+	case "Polygon":
+		var rings [][][2]float64
+		if err := json.Unmarshal(doc.Coordinates, &rings); err != nil {
+			return nil, fmt.Errorf("h3: decoding polygon coordinates: %w", err)
+		}
+		return []*GeoPolygon{geoPolygonFromRings(rings)}, nil
+
+	case "MultiPolygon":
+		var polygons [][][][2]float64
+		if err := json.Unmarshal(doc.Coordinates, &polygons); err != nil {
+			return nil, fmt.Errorf("h3: decoding multipolygon coordinates: %w", err)
+		}
+		if len(polygons) == 0 {
+			return nil, fmt.Errorf("h3: multipolygon has no members")
+		}
+		out := make([]*GeoPolygon, len(polygons))
+		for i, rings := range polygons {
+			out[i] = geoPolygonFromRings(rings)
+		}
+		return out, nil
+
+	default:
+		return nil, fmt.Errorf("h3: unsupported geometry type %q", doc.Type)
+	}
+}
+
+// geoPolygonFromRings builds a GeoPolygon from GeoJSON ring coordinates,
+// where rings[0] is the exterior ring and any further rings become holes.
+// GeoJSON orders each coordinate pair as [lon, lat] degrees.
+func geoPolygonFromRings(rings [][][2]float64) *GeoPolygon {
+	gp := &GeoPolygon{
+		geofence: geofenceFromRing(rings[0]),
+	}
+
+	if len(rings) > 1 {
+		gp.numHoles = len(rings) - 1
+		gp.holes = make([]Geofence, gp.numHoles)
+		for i, ring := range rings[1:] {
+			gp.holes[i] = geofenceFromRing(ring)
+		}
+	}
+
+	return gp
+}
+
+// geofenceFromRing builds a Geofence from a GeoJSON linear ring. GeoJSON
+// rings are closed (the first position is repeated as the last); Geofence's
+// NewIterate assumes an open ring and closes it itself by wrapping back to
+// vertex 0, so the duplicated closing position is dropped here.
+func geofenceFromRing(ring [][2]float64) Geofence {
+	if len(ring) > 1 && ring[0] == ring[len(ring)-1] {
+		ring = ring[:len(ring)-1]
+	}
+
+	verts := make([]geoCoord, len(ring))
+	for i, pt := range ring {
+		verts[i] = *(&geoCoord{Lat: pt[1], Lon: pt[0]}).AsRadians()
+	}
+	return Geofence{numVerts: len(verts), verts: verts}
+}
+
+// MarshalGeoJSON encodes a GeoBoundary, Geofence or LinkedGeoPolygon back
+// into GeoJSON, converting the internal radian geoCoords into lon/lat
+// degree pairs. A GeoBoundary or Geofence encodes as a single-ring Polygon
+// geometry; a LinkedGeoPolygon, which may chain several polygons and hole
+// loops, encodes as a MultiPolygon geometry.
+func MarshalGeoJSON(v interface{}) ([]byte, error) {
+	switch g := v.(type) {
+	case *GeoBoundary:
+		return marshalPolygonRing(degreeRing(g.Verts))
+
+	case *Geofence:
+		return marshalPolygonRing(degreeRing(g.verts))
+
+	case *LinkedGeoPolygon:
+		return marshalLinkedGeoPolygon(g)
+
+	default:
+		return nil, fmt.Errorf("h3: MarshalGeoJSON: unsupported type %T", v)
+	}
+}
+
+func marshalPolygonRing(ring [][2]float64) ([]byte, error) {
+	coords, err := json.Marshal([][][2]float64{closeRing(ring)})
+	if err != nil {
+		return nil, fmt.Errorf("h3: marshaling Polygon coordinates: %w", err)
+	}
+	return json.Marshal(geoJSONDoc{Type: "Polygon", Coordinates: coords})
+}
+
+func marshalLinkedGeoPolygon(first *LinkedGeoPolygon) ([]byte, error) {
+	var polygons [][][][2]float64
+
+	for poly := first; poly != nil; poly = poly.next {
+		var rings [][][2]float64
+		for loop := poly.first; loop != nil; loop = loop.next {
+			rings = append(rings, closeRing(ringFromLinkedGeoLoop(loop)))
+		}
+		polygons = append(polygons, rings)
+	}
+
+	coords, err := json.Marshal(polygons)
+	if err != nil {
+		return nil, fmt.Errorf("h3: marshaling MultiPolygon coordinates: %w", err)
+	}
+
+	return json.Marshal(geoJSONDoc{Type: "MultiPolygon", Coordinates: coords})
+}
+
+func ringFromLinkedGeoLoop(loop *LinkedGeoLoop) [][2]float64 {
+	var ring [][2]float64
+
+	iterate := loop.NewIterate()
+	var a, b geoCoord
+	for iterate(&a, &b) {
+		deg := a.AsDegrees()
+		ring = append(ring, [2]float64{deg.Lon, deg.Lat})
+	}
+
+	return ring
+}
+
+// degreeRing converts a slice of radian geoCoords into GeoJSON's
+// [lon, lat] degree pair ordering.
+func degreeRing(verts []geoCoord) [][2]float64 {
+	ring := make([][2]float64, len(verts))
+	for i, v := range verts {
+		deg := v.AsDegrees()
+		ring[i] = [2]float64{deg.Lon, deg.Lat}
+	}
+	return ring
+}
+
+// closeRing appends a copy of the first position to the end of ring if it
+// isn't already closed, since every GeoJSON linear ring must repeat its
+// first position as its last.
+func closeRing(ring [][2]float64) [][2]float64 {
+	if len(ring) == 0 || ring[0] == ring[len(ring)-1] {
+		return ring
+	}
+	return append(ring, ring[0])
+}
@@ -0,0 +1,106 @@
+package h3
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestParseGeoJSONPolygonWithHole(t *testing.T) {
+	const doc = `{
+		"type": "Polygon",
+		"coordinates": [
+			[[-1,-1],[1,-1],[1,1],[-1,1],[-1,-1]],
+			[[-0.2,-0.2],[0.2,-0.2],[0.2,0.2],[-0.2,0.2],[-0.2,-0.2]]
+		]
+	}`
+
+	fc, err := ParseGeoJSON(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("ParseGeoJSON: %v", err)
+	}
+	if len(fc.Features) != 1 {
+		t.Fatalf("expected 1 feature, got %d", len(fc.Features))
+	}
+
+	gp := fc.Features[0].Polygon
+	if got := len(gp.geofence.verts); got != 4 {
+		t.Errorf("expected exterior ring's duplicated closing vertex to be dropped, got %d verts", got)
+	}
+	if gp.numHoles != 1 || len(gp.holes[0].verts) != 4 {
+		t.Errorf("expected 1 hole with 4 verts (closing vertex dropped), got numHoles=%d", gp.numHoles)
+	}
+}
+
+func TestParseGeoJSONMultiPolygonFansOutFeatures(t *testing.T) {
+	const doc = `{
+		"type": "MultiPolygon",
+		"coordinates": [
+			[[[-1,-1],[1,-1],[1,1],[-1,1],[-1,-1]]],
+			[[[10,10],[12,10],[12,12],[10,12],[10,10]]]
+		]
+	}`
+
+	fc, err := ParseGeoJSON(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("ParseGeoJSON: %v", err)
+	}
+	if len(fc.Features) != 2 {
+		t.Fatalf("expected MultiPolygon to fan out into 2 features, got %d", len(fc.Features))
+	}
+	for i, f := range fc.Features {
+		if len(f.Polygon.geofence.verts) != 4 {
+			t.Errorf("feature %d: expected 4 verts, got %d", i, len(f.Polygon.geofence.verts))
+		}
+	}
+}
+
+func TestParseGeoJSONFeatureCollectionPreservesProperties(t *testing.T) {
+	const doc = `{
+		"type": "FeatureCollection",
+		"features": [
+			{
+				"type": "Feature",
+				"properties": {"name": "a"},
+				"geometry": {"type": "Polygon", "coordinates": [[[-1,-1],[1,-1],[1,1],[-1,1],[-1,-1]]]}
+			}
+		]
+	}`
+
+	fc, err := ParseGeoJSON(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("ParseGeoJSON: %v", err)
+	}
+	if len(fc.Features) != 1 || fc.Features[0].Properties["name"] != "a" {
+		t.Fatalf("expected feature properties to be preserved, got %+v", fc.Features)
+	}
+}
+
+func TestMarshalGeoJSONRoundTripClosesRing(t *testing.T) {
+	gf := squareGeofence(-1, -1, 1, 1)
+
+	b, err := MarshalGeoJSON(&gf)
+	if err != nil {
+		t.Fatalf("MarshalGeoJSON: %v", err)
+	}
+
+	var doc geoJSONDoc
+	var rings [][][2]float64
+	if err := json.Unmarshal(b, &doc); err != nil {
+		t.Fatalf("decoding marshaled document: %v", err)
+	}
+	if err := json.Unmarshal(doc.Coordinates, &rings); err != nil {
+		t.Fatalf("decoding marshaled coordinates: %v", err)
+	}
+	if len(rings) != 1 || len(rings[0]) != 5 || rings[0][0] != rings[0][4] {
+		t.Fatalf("expected one closed 5-position ring, got %v", rings)
+	}
+
+	fc, err := ParseGeoJSON(strings.NewReader(string(b)))
+	if err != nil {
+		t.Fatalf("round-trip ParseGeoJSON: %v", err)
+	}
+	if len(fc.Features) != 1 || len(fc.Features[0].Polygon.geofence.verts) != 4 {
+		t.Fatalf("expected round trip to preserve 4 exterior vertices, got %+v", fc.Features)
+	}
+}
@@ -0,0 +1,8 @@
+package h3
+
+// H3Index is a hierarchical, hexagonal geospatial indexing system index, as
+// produced and consumed by the core cell-indexing engine (base cells,
+// FaceIJK math, resolution digits, ...). That engine is not part of this
+// tree, so H3Index is declared here purely as the value type the
+// GeoJSON/polyfill/limiter helpers in this package exchange with it.
+type H3Index uint64
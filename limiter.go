@@ -0,0 +1,320 @@
+package h3
+
+import (
+	"fmt"
+	"io"
+	"math"
+)
+
+// Limiter tests points, cells and linked geo structures against a region
+// boundary, optionally grown outward by a buffer distance. It mirrors the
+// "limit-to with buffer" pattern common in OSM import pipelines, where a
+// region is grown slightly before containment testing so cells whose
+// boundary merely touches the edge of the region are still included.
+type Limiter struct {
+	region *GeoPolygon
+	buffer float64 // meters
+	toGeo  func(H3Index) *geoCoord
+}
+
+// NewLimiter builds a Limiter testing containment against region, with no
+// buffer.
+func NewLimiter(region *GeoPolygon) *Limiter {
+	return &Limiter{region: region}
+}
+
+// NewLimiterFromGeoJSON builds a Limiter from the first feature decoded out
+// of a GeoJSON document, with no buffer.
+func NewLimiterFromGeoJSON(r io.Reader) (*Limiter, error) {
+	fc, err := ParseGeoJSON(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(fc.Features) == 0 {
+		return nil, fmt.Errorf("h3: GeoJSON document has no features")
+	}
+	return NewLimiter(fc.Features[0].Polygon), nil
+}
+
+// WithBuffer returns a copy of l that grows its region outward by the given
+// buffer distance, in meters, before every containment test.
+func (l *Limiter) WithBuffer(meters float64) *Limiter {
+	out := *l
+	out.buffer = meters
+	return &out
+}
+
+// WithIndexing returns a copy of l that resolves a cell's center coordinate
+// via toGeo (typically the core engine's ToGeo) for use by ContainsCell and
+// Filter. That engine is not part of this tree, so it must be supplied
+// explicitly; ContainsCell and Filter panic if called before WithIndexing.
+func (l *Limiter) WithIndexing(toGeo func(H3Index) *geoCoord) *Limiter {
+	out := *l
+	out.toGeo = toGeo
+	return &out
+}
+
+// radBuffer converts the Limiter's buffer distance, in meters, to an
+// angular distance in radians along the earth's surface.
+func (l *Limiter) radBuffer() float64 {
+	return l.buffer / earthRadiusMeters
+}
+
+// Contains reports whether c falls inside the limiter's region, grown
+// outward by its buffer distance if one was configured.
+func (l *Limiter) Contains(c geoCoord) bool {
+	if pointInGeofence(l.region.geofence, c, l.radBuffer()) {
+		for _, hole := range l.region.holes {
+			if pointInGeofence(hole, c, -l.radBuffer()) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// ContainsCell reports whether h's center falls inside the limiter's
+// region. It requires a Limiter built with WithIndexing.
+func (l *Limiter) ContainsCell(h H3Index) bool {
+	return l.Contains(*l.toGeo(h))
+}
+
+// Filter returns the subset of cells whose center falls inside the
+// limiter's region. It requires a Limiter built with WithIndexing.
+func (l *Limiter) Filter(cells []H3Index) []H3Index {
+	out := make([]H3Index, 0, len(cells))
+	for _, c := range cells {
+		if l.ContainsCell(c) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// ClipLinkedGeoPolygon returns a new LinkedGeoPolygon chain with every loop
+// of lgp (as produced e.g. by h3SetToLinkedGeo) clipped against the
+// limiter's region boundary via Sutherland-Hodgman polygon clipping: edges
+// crossing the boundary get a new vertex inserted exactly at the crossing,
+// so a loop that only dips into the region comes back cut down to the part
+// that's actually inside it, rather than surviving whole.
+//
+// The clip is against the region's exterior ring only, unbuffered: the
+// limiter's holes aren't subtracted from the clipped output, and WithBuffer
+// only affects Contains/ContainsCell/Filter, not this method.
+func (l *Limiter) ClipLinkedGeoPolygon(lgp *LinkedGeoPolygon) *LinkedGeoPolygon {
+	var head, tail *LinkedGeoPolygon
+
+	for poly := lgp; poly != nil; poly = poly.next {
+		clipped := l.clipPolygon(poly)
+		if clipped == nil {
+			continue
+		}
+		if head == nil {
+			head = clipped
+		} else {
+			tail.next = clipped
+		}
+		tail = clipped
+	}
+
+	return head
+}
+
+func (l *Limiter) clipPolygon(poly *LinkedGeoPolygon) *LinkedGeoPolygon {
+	var head, tail *LinkedGeoLoop
+
+	for loop := poly.first; loop != nil; loop = loop.next {
+		verts := sutherlandHodgmanClip(loopVerts(loop), l.region.geofence.verts)
+
+		kept := loopFromVerts(verts)
+		if kept == nil {
+			continue
+		}
+		if head == nil {
+			head = kept
+		} else {
+			tail.next = kept
+		}
+		tail = kept
+	}
+
+	if head == nil {
+		return nil
+	}
+
+	return &LinkedGeoPolygon{first: head, last: tail}
+}
+
+// loopVerts collects a LinkedGeoLoop's vertices in order.
+func loopVerts(loop *LinkedGeoLoop) []geoCoord {
+	var verts []geoCoord
+	for c := loop.first; c != nil; c = c.next {
+		verts = append(verts, c.vertex)
+	}
+	return verts
+}
+
+// loopFromVerts builds a fresh LinkedGeoLoop from verts, or nil if too few
+// vertices remain to form a loop.
+func loopFromVerts(verts []geoCoord) *LinkedGeoLoop {
+	if len(verts) < 3 {
+		return nil
+	}
+
+	var first, last *LinkedgeoCoord
+	for _, v := range verts {
+		c := &LinkedgeoCoord{vertex: v}
+		if first == nil {
+			first = c
+		} else {
+			last.next = c
+		}
+		last = c
+	}
+
+	return &LinkedGeoLoop{first: first, last: last}
+}
+
+// sutherlandHodgmanClip clips the subject polygon against the clip polygon,
+// both given as CCW vertex rings, inserting a new vertex at every edge
+// crossing. clip is assumed convex, as Sutherland-Hodgman requires for a
+// correct result; for a concave limiter region this is an approximation.
+func sutherlandHodgmanClip(subject, clip []geoCoord) []geoCoord {
+	output := subject
+	n := len(clip)
+
+	for i, j := 0, n-1; i < n && len(output) > 0; j, i = i, i+1 {
+		clipA, clipB := clip[j], clip[i]
+		input := output
+		output = nil
+
+		m := len(input)
+		for k, l := 0, m-1; k < m; l, k = k, k+1 {
+			cur, prev := input[k], input[l]
+			curIn := isLeftOf(clipA, clipB, cur)
+			prevIn := isLeftOf(clipA, clipB, prev)
+
+			switch {
+			case curIn && prevIn:
+				output = append(output, cur)
+			case curIn && !prevIn:
+				output = append(output, segmentIntersect(prev, cur, clipA, clipB), cur)
+			case !curIn && prevIn:
+				output = append(output, segmentIntersect(prev, cur, clipA, clipB))
+			}
+		}
+	}
+
+	return output
+}
+
+// isLeftOf reports whether p lies on the interior side of the directed edge
+// a->b of a CCW polygon.
+func isLeftOf(a, b, p geoCoord) bool {
+	cross := (b.Lon-a.Lon)*(p.Lat-a.Lat) - (b.Lat-a.Lat)*(p.Lon-a.Lon)
+	return cross >= 0
+}
+
+// segmentIntersect returns the point where line p1-p2 crosses line p3-p4.
+// Sutherland-Hodgman only calls it for edges already known to straddle the
+// clip line, so the parallel case (denom == 0) is never hit in practice;
+// p2 is returned defensively if it ever is.
+func segmentIntersect(p1, p2, p3, p4 geoCoord) geoCoord {
+	dx1, dy1 := p2.Lon-p1.Lon, p2.Lat-p1.Lat
+	dx2, dy2 := p4.Lon-p3.Lon, p4.Lat-p3.Lat
+
+	denom := dx1*dy2 - dy1*dx2
+	if denom == 0 {
+		return p2
+	}
+
+	t := ((p3.Lon-p1.Lon)*dy2 - (p3.Lat-p1.Lat)*dx2) / denom
+	return geoCoord{Lon: p1.Lon + t*dx1, Lat: p1.Lat + t*dy1}
+}
+
+// pointInGeofence is a point-in-polygon test over the geofence's vertices,
+// expressed in radians. With buffer == 0 it is a standard ray-casting test.
+// A nonzero buffer is applied as a distance from the polygon boundary
+// rather than as an offset to the ray-casting scanline, so it grows (or,
+// for negative buffer, shrinks) the region uniformly in every direction,
+// including past its northernmost/southernmost vertices: a point is
+// considered inside if it is inside the raw polygon, or (buffer > 0) within
+// buffer of the boundary, or, for a negative buffer used to erode a hole,
+// only if it is strictly farther than |buffer| inside it.
+func pointInGeofence(g Geofence, c geoCoord, buffer float64) bool {
+	inside := rawPointInPolygon(g, c)
+
+	if buffer == 0 {
+		return inside
+	}
+
+	d := distanceToBoundary(g, c)
+	if buffer > 0 {
+		return inside || d <= buffer
+	}
+	return inside && d > -buffer
+}
+
+// rawPointInPolygon is a standard ray-casting point-in-polygon test.
+func rawPointInPolygon(g Geofence, c geoCoord) bool {
+	inside := false
+	n := len(g.verts)
+
+	for i, j := 0, n-1; i < n; j, i = i, i+1 {
+		vi, vj := g.verts[i], g.verts[j]
+
+		if (vi.Lat > c.Lat) != (vj.Lat > c.Lat) {
+			slope := (vj.Lon - vi.Lon) / (vj.Lat - vi.Lat)
+			crossingLon := vi.Lon + (c.Lat-vi.Lat)*slope
+
+			if c.Lon < crossingLon {
+				inside = !inside
+			}
+		}
+	}
+
+	return inside
+}
+
+// distanceToBoundary returns c's shortest distance, in radians, to any edge
+// of g's boundary.
+func distanceToBoundary(g Geofence, c geoCoord) float64 {
+	min := math.Inf(1)
+	n := len(g.verts)
+
+	for i, j := 0, n-1; i < n; j, i = i, i+1 {
+		if d := distanceToSegment(g.verts[j], g.verts[i], c); d < min {
+			min = d
+		}
+	}
+
+	return min
+}
+
+// distanceToSegment approximates c's distance to segment a-b in radians,
+// scaling longitude by cos(c.Lat) so that east/west distances away from the
+// equator aren't overstated. rawPointInPolygon's ray-casting test uses
+// unscaled lon/lat.
+func distanceToSegment(a, b, c geoCoord) float64 {
+	cosLat := math.Cos(c.Lat)
+
+	ax, ay := a.Lon*cosLat, a.Lat
+	bx, by := b.Lon*cosLat, b.Lat
+	px, py := c.Lon*cosLat, c.Lat
+
+	dx, dy := bx-ax, by-ay
+	if dx == 0 && dy == 0 {
+		return math.Hypot(px-ax, py-ay)
+	}
+
+	t := ((px-ax)*dx + (py-ay)*dy) / (dx*dx + dy*dy)
+	if t < 0 {
+		t = 0
+	} else if t > 1 {
+		t = 1
+	}
+
+	nx, ny := ax+t*dx, ay+t*dy
+	return math.Hypot(px-nx, py-ny)
+}
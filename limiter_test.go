@@ -0,0 +1,113 @@
+package h3
+
+import "testing"
+
+func TestLimiterContains(t *testing.T) {
+	region := &GeoPolygon{geofence: squareGeofence(-1, -1, 1, 1)}
+	l := NewLimiter(region)
+
+	if !l.Contains(geo(0, 0)) {
+		t.Error("expected origin to be inside region")
+	}
+	if l.Contains(geo(5, 5)) {
+		t.Error("expected far point to be outside region")
+	}
+}
+
+func TestLimiterContainsHole(t *testing.T) {
+	region := &GeoPolygon{
+		geofence: squareGeofence(-1, -1, 1, 1),
+		numHoles: 1,
+		holes:    []Geofence{squareGeofence(-0.2, -0.2, 0.2, 0.2)},
+	}
+	l := NewLimiter(region)
+
+	if l.Contains(geo(0, 0)) {
+		t.Error("expected hole center to be excluded")
+	}
+	if !l.Contains(geo(0.5, 0.5)) {
+		t.Error("expected point outside the hole but inside the region to be included")
+	}
+}
+
+func TestLimiterContainsBuffer(t *testing.T) {
+	region := &GeoPolygon{geofence: squareGeofence(-1, -1, 1, 1)}
+	justOutside := geo(1.05, 0) // ~0.05deg ≈ 5.5km past the edge
+
+	unbuffered := NewLimiter(region)
+	if unbuffered.Contains(justOutside) {
+		t.Fatal("expected point just outside region, with no buffer, to be excluded")
+	}
+
+	buffered := unbuffered.WithBuffer(10000) // 10km, comfortably more than 5.5km
+	if !buffered.Contains(justOutside) {
+		t.Error("expected point just outside region to be included once buffered")
+	}
+}
+
+func TestLimiterFilter(t *testing.T) {
+	region := &GeoPolygon{geofence: squareGeofence(-1, -1, 1, 1)}
+	centers := map[H3Index]geoCoord{
+		1: geo(0, 0),
+		2: geo(10, 10),
+	}
+	l := NewLimiter(region).WithIndexing(func(h H3Index) *geoCoord {
+		c := centers[h]
+		return &c
+	})
+
+	got := l.Filter([]H3Index{1, 2})
+	if len(got) != 1 || got[0] != 1 {
+		t.Fatalf("expected only index 1 to survive filtering, got %v", got)
+	}
+}
+
+// TestClipLinkedGeoPolygonDoesNotBalloon reproduces the case a whole-loop
+// filter gets wrong: a loop with one vertex inside a small limit region and
+// the other two on the far side of the globe must come back clipped down to
+// (approximately) the region, not pass through untouched.
+func TestClipLinkedGeoPolygonDoesNotBalloon(t *testing.T) {
+	region := &GeoPolygon{geofence: squareGeofence(-1, -1, 1, 1)}
+	l := NewLimiter(region)
+
+	loop := loopFromVerts([]geoCoord{
+		geo(0.5, 0.5),
+		geo(170, 80),
+		geo(-170, -80),
+	})
+	lgp := &LinkedGeoPolygon{first: loop, last: loop}
+
+	clipped := l.ClipLinkedGeoPolygon(lgp)
+	if clipped == nil {
+		t.Fatal("expected the portion of the loop inside the region to survive clipping")
+	}
+
+	const margin = 1.01 // degrees; region is [-1, 1], allow float slop
+	for poly := clipped; poly != nil; poly = poly.next {
+		for lp := poly.first; lp != nil; lp = lp.next {
+			for c := lp.first; c != nil; c = c.next {
+				deg := c.vertex.AsDegrees()
+				if deg.Lon < -margin || deg.Lon > margin || deg.Lat < -margin || deg.Lat > margin {
+					t.Fatalf("clipped vertex %+v lies far outside the limiter region", deg)
+				}
+			}
+		}
+	}
+}
+
+func TestClipLinkedGeoPolygonFullyOutsideDrops(t *testing.T) {
+	region := &GeoPolygon{geofence: squareGeofence(-1, -1, 1, 1)}
+	l := NewLimiter(region)
+
+	loop := loopFromVerts([]geoCoord{
+		geo(10, 10),
+		geo(12, 10),
+		geo(12, 12),
+		geo(10, 12),
+	})
+	lgp := &LinkedGeoPolygon{first: loop, last: loop}
+
+	if clipped := l.ClipLinkedGeoPolygon(lgp); clipped != nil {
+		t.Fatalf("expected a loop entirely outside the region to be dropped, got %+v", clipped)
+	}
+}
@@ -0,0 +1,189 @@
+package h3
+
+// linkedGeoPoolBucket is a free list of pre-allocated nodes for a single
+// size class. Coord and loop nodes are pooled separately since a
+// LinkedGeoPolygon's loops vastly outnumber its polygons but are themselves
+// outnumbered by coords.
+type linkedGeoPoolBucket struct {
+	size   int
+	coords []*LinkedgeoCoord
+	loops  []*LinkedGeoLoop
+}
+
+// LinkedGeoPool is a tiered, size-bucketed pool of LinkedgeoCoord and
+// LinkedGeoLoop nodes. Polyfilling large regions, or converting large cell
+// sets to multipolygons, allocates one node per vertex; reusing nodes across
+// queries avoids paying that allocation cost every time. Callers build
+// polygons from pooled nodes with NewLinkedGeoPolygon and return the nodes
+// to the pool afterwards with Release; no h3SetToLinkedGeo or polyfill entry
+// point in this tree takes a pool.
+//
+// A LinkedGeoPool is not safe for concurrent use.
+type LinkedGeoPool struct {
+	buckets []*linkedGeoPoolBucket
+}
+
+// NewLinkedGeoPool creates a LinkedGeoPool with free-list buckets sized at
+// the given vertex counts, e.g. NewLinkedGeoPool(16, 64, 256, 1024). Bucket
+// sizes should be given in increasing order.
+func NewLinkedGeoPool(bucketSizes ...int) *LinkedGeoPool {
+	buckets := make([]*linkedGeoPoolBucket, len(bucketSizes))
+	for i, size := range bucketSizes {
+		buckets[i] = &linkedGeoPoolBucket{size: size}
+	}
+	return &LinkedGeoPool{buckets: buckets}
+}
+
+// bucketFor returns the smallest bucket able to satisfy a request for n
+// nodes, or nil if n exceeds every configured bucket size.
+func (p *LinkedGeoPool) bucketFor(n int) *linkedGeoPoolBucket {
+	for _, b := range p.buckets {
+		if n <= b.size {
+			return b
+		}
+	}
+	return nil
+}
+
+// GetCoord returns a LinkedgeoCoord from the smallest bucket that can hold a
+// loop of hintVerts vertices, allocating a fresh one if the bucket is empty
+// or hintVerts is larger than every configured bucket.
+func (p *LinkedGeoPool) GetCoord(hintVerts int) *LinkedgeoCoord {
+	b := p.bucketFor(hintVerts)
+	if b == nil || len(b.coords) == 0 {
+		return &LinkedgeoCoord{}
+	}
+
+	last := len(b.coords) - 1
+	c := b.coords[last]
+	b.coords[last] = nil
+	b.coords = b.coords[:last]
+	*c = LinkedgeoCoord{}
+	return c
+}
+
+// GetLoop returns a LinkedGeoLoop from the smallest bucket that can hold a
+// polygon of hintLoops loops, allocating a fresh one if the bucket is empty
+// or hintLoops is larger than every configured bucket.
+func (p *LinkedGeoPool) GetLoop(hintLoops int) *LinkedGeoLoop {
+	b := p.bucketFor(hintLoops)
+	if b == nil || len(b.loops) == 0 {
+		return &LinkedGeoLoop{}
+	}
+
+	last := len(b.loops) - 1
+	l := b.loops[last]
+	b.loops[last] = nil
+	b.loops = b.loops[:last]
+	*l = LinkedGeoLoop{}
+	return l
+}
+
+// Release walks lgp and every coord/loop reachable from it and returns them
+// to the pool's free lists, so they can be handed back out by a later
+// GetCoord/GetLoop call. LinkedGeoPolygon nodes themselves are not pooled,
+// and coords (loops) of a loop (polygon) larger than every bucket size are
+// dropped rather than pooled. lgp must not be used again after Release.
+func (p *LinkedGeoPool) Release(lgp *LinkedGeoPolygon) {
+	for poly := lgp; poly != nil; {
+		nextPoly := poly.next
+
+		nLoops := 0
+		for loop := poly.first; loop != nil; loop = loop.next {
+			nLoops++
+		}
+
+		for loop := poly.first; loop != nil; {
+			nextLoop := loop.next
+
+			nVerts := 0
+			for c := loop.first; c != nil; c = c.next {
+				nVerts++
+			}
+
+			for c := loop.first; c != nil; {
+				nextCoord := c.next
+				p.putCoord(c, nVerts)
+				c = nextCoord
+			}
+
+			p.putLoop(loop, nLoops)
+			loop = nextLoop
+		}
+
+		poly = nextPoly
+	}
+}
+
+func (p *LinkedGeoPool) putCoord(c *LinkedgeoCoord, hintVerts int) {
+	b := p.bucketFor(hintVerts)
+	if b == nil {
+		return
+	}
+	b.coords = append(b.coords, c)
+}
+
+func (p *LinkedGeoPool) putLoop(l *LinkedGeoLoop, hintLoops int) {
+	b := p.bucketFor(hintLoops)
+	if b == nil {
+		return
+	}
+	b.loops = append(b.loops, l)
+}
+
+// Reset discards every pooled node, dropping them for garbage collection.
+// Use it to bound the pool's retained memory between unrelated batches of
+// queries.
+func (p *LinkedGeoPool) Reset() {
+	for _, b := range p.buckets {
+		b.coords = nil
+		b.loops = nil
+	}
+}
+
+// NewLinkedGeoPolygon builds a LinkedGeoPolygon, using nodes drawn from the
+// pool, from ring data: rings[0] is the exterior loop and any further rings
+// become hole loops, mirroring GeoPolygon's exterior-plus-holes layout. This
+// is the node-construction step a real h3SetToLinkedGeo/polyfill entry
+// point performs per output polygon once it has walked the hex grid and
+// decided on ring vertices; the grid walk itself is not part of this tree.
+func (p *LinkedGeoPool) NewLinkedGeoPolygon(rings [][]geoCoord) *LinkedGeoPolygon {
+	if len(rings) == 0 {
+		return nil
+	}
+
+	poly := &LinkedGeoPolygon{}
+	var lastLoop *LinkedGeoLoop
+
+	for _, ring := range rings {
+		loop := p.GetLoop(len(rings))
+		loop.first, loop.last = p.newCoordChain(ring)
+
+		if poly.first == nil {
+			poly.first = loop
+		} else {
+			lastLoop.next = loop
+		}
+		lastLoop = loop
+	}
+
+	poly.last = lastLoop
+	return poly
+}
+
+// newCoordChain builds a single loop's coord chain from its vertices, using
+// nodes drawn from the pool.
+func (p *LinkedGeoPool) newCoordChain(ring []geoCoord) (first, last *LinkedgeoCoord) {
+	for _, v := range ring {
+		c := p.GetCoord(len(ring))
+		c.vertex = v
+
+		if first == nil {
+			first = c
+		} else {
+			last.next = c
+		}
+		last = c
+	}
+	return first, last
+}
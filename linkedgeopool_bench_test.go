@@ -0,0 +1,65 @@
+package h3
+
+import "testing"
+
+// benchRings is a stand-in for the ring set a large multipolygon conversion
+// would produce: one outer loop with enough vertices to show allocation
+// cost amortizing across iterations.
+func benchRings(nVerts int) [][]geoCoord {
+	ring := make([]geoCoord, nVerts)
+	for i := range ring {
+		ring[i] = geoCoord{Lat: float64(i), Lon: float64(i)}
+	}
+	return [][]geoCoord{ring}
+}
+
+// newLinkedGeoPolygonUnpooled builds the same shape as
+// LinkedGeoPool.NewLinkedGeoPolygon, allocating every node fresh, as a
+// baseline for BenchmarkLinkedGeoPolygonAlloc.
+func newLinkedGeoPolygonUnpooled(rings [][]geoCoord) *LinkedGeoPolygon {
+	poly := &LinkedGeoPolygon{}
+	var lastLoop *LinkedGeoLoop
+
+	for _, ring := range rings {
+		loop := &LinkedGeoLoop{}
+		var first, last *LinkedgeoCoord
+		for _, v := range ring {
+			c := &LinkedgeoCoord{vertex: v}
+			if first == nil {
+				first = c
+			} else {
+				last.next = c
+			}
+			last = c
+		}
+		loop.first, loop.last = first, last
+
+		if poly.first == nil {
+			poly.first = loop
+		} else {
+			lastLoop.next = loop
+		}
+		lastLoop = loop
+	}
+
+	poly.last = lastLoop
+	return poly
+}
+
+func BenchmarkLinkedGeoPolygonAlloc(b *testing.B) {
+	rings := benchRings(1000)
+
+	b.Run("unpooled", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = newLinkedGeoPolygonUnpooled(rings)
+		}
+	})
+
+	b.Run("pooled", func(b *testing.B) {
+		pool := NewLinkedGeoPool(2048)
+		for i := 0; i < b.N; i++ {
+			lgp := pool.NewLinkedGeoPolygon(rings)
+			pool.Release(lgp)
+		}
+	})
+}
@@ -0,0 +1,38 @@
+package h3
+
+// CellResult is one H3 index produced by PolyfillFeatures, tagged with the
+// properties of the Feature whose polygon produced it.
+type CellResult struct {
+	Index      H3Index
+	Properties map[string]string
+}
+
+// PolyfillFeatures polyfills each Feature's polygon at resolution res, using
+// polyfill to do the actual polygon-to-cells work, and returns every
+// produced cell tagged with that feature's Properties. This lets callers
+// import a set of categorized regions (admin boundaries, land-use polygons,
+// limit-to regions, ...) in a single pass and get back cells already
+// associated with their source metadata, rather than calling polyfill per
+// feature and re-associating properties externally.
+//
+// polyfill is taken as a parameter, typically the core engine's Polyfill,
+// rather than called directly: that engine (base cells, FaceIJK math, ...)
+// is not part of this tree.
+func PolyfillFeatures(features []Feature, res int, polyfill func(*GeoPolygon, int) []H3Index) []CellResult {
+	var results []CellResult
+
+	for _, f := range features {
+		if f.Polygon == nil {
+			continue
+		}
+
+		for _, index := range polyfill(f.Polygon, res) {
+			results = append(results, CellResult{
+				Index:      index,
+				Properties: f.Properties,
+			})
+		}
+	}
+
+	return results
+}
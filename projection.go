@@ -0,0 +1,144 @@
+package h3
+
+import "math"
+
+// earthRadiusMeters is the WGS84/Web Mercator spherical earth radius used
+// for EPSG:3857 projection math.
+const earthRadiusMeters = 6378137.0
+
+// Projector converts coordinates between the internal WGS84 radian lat/lon
+// representation H3 operates on and some other SRID, so that polygons
+// expressed in that SRID can be handed directly to Polyfill, and indexed
+// cells can be produced back in it.
+type Projector interface {
+	// ToWGS84 converts a coordinate expressed in the projector's native SRID
+	// into WGS84 radians.
+	ToWGS84(c geoCoord) geoCoord
+
+	// FromWGS84 converts a WGS84 radians coordinate into the projector's
+	// native SRID.
+	FromWGS84(c geoCoord) geoCoord
+}
+
+// WGS84 is the plain WGS84 lat/lon Projector: its native SRID is degrees,
+// the same ordering ParseGeoJSON decodes, so ToWGS84/FromWGS84 are just the
+// existing degree/radian conversions.
+var WGS84 Projector = wgs84Projector{}
+
+type wgs84Projector struct{}
+
+func (wgs84Projector) ToWGS84(c geoCoord) geoCoord   { return *c.AsRadians() }
+func (wgs84Projector) FromWGS84(c geoCoord) geoCoord { return *c.AsDegrees() }
+
+// WebMercator is a Projector for EPSG:3857 Web Mercator, expressed in
+// meters. It lets tile-pipeline users hand raw Mercator coordinates to
+// Polyfill without pre-converting them to WGS84 themselves.
+var WebMercator Projector = webMercatorProjector{}
+
+type webMercatorProjector struct{}
+
+// ToWGS84 treats c.Lon/c.Lat as EPSG:3857 meters and converts them to WGS84
+// radians.
+func (webMercatorProjector) ToWGS84(c geoCoord) geoCoord {
+	return geoCoord{
+		Lon: c.Lon / earthRadiusMeters,
+		Lat: 2*math.Atan(math.Exp(c.Lat/earthRadiusMeters)) - math.Pi/2,
+	}
+}
+
+// FromWGS84 converts a WGS84 radians coordinate into EPSG:3857 meters,
+// returned as a geoCoord whose Lon/Lat fields hold the projected x/y.
+func (webMercatorProjector) FromWGS84(c geoCoord) geoCoord {
+	return geoCoord{
+		Lon: c.Lon * earthRadiusMeters,
+		Lat: math.Log(math.Tan(math.Pi/4+c.Lat/2)) * earthRadiusMeters,
+	}
+}
+
+// Reproject returns a copy of g with every vertex, in the exterior geofence
+// and in every hole, converted from p's native SRID into the WGS84 radians
+// H3 requires.
+func (g *GeoPolygon) Reproject(p Projector) *GeoPolygon {
+	out := &GeoPolygon{
+		geofence: reprojectGeofence(g.geofence, p),
+		numHoles: g.numHoles,
+	}
+
+	if g.numHoles > 0 {
+		out.holes = make([]Geofence, g.numHoles)
+		for i, h := range g.holes {
+			out.holes[i] = reprojectGeofence(h, p)
+		}
+	}
+
+	return out
+}
+
+// Reproject returns a copy of g with every polygon's vertices converted
+// from p's native SRID into the WGS84 radians H3 requires.
+func (g *GeoMultiPolygon) Reproject(p Projector) *GeoMultiPolygon {
+	out := &GeoMultiPolygon{numPolygons: g.numPolygons}
+	if g.numPolygons > 0 {
+		out.polygons = make([]GeoPolygon, g.numPolygons)
+		for i := range g.polygons {
+			out.polygons[i] = *g.polygons[i].Reproject(p)
+		}
+	}
+	return out
+}
+
+// Reproject returns a copy of g with every vertex converted from p's native
+// SRID into the WGS84 radians H3 requires.
+func (g *Geofence) Reproject(p Projector) *Geofence {
+	out := reprojectGeofence(*g, p)
+	return &out
+}
+
+func reprojectGeofence(g Geofence, p Projector) Geofence {
+	verts := make([]geoCoord, len(g.verts))
+	for i, v := range g.verts {
+		verts[i] = p.ToWGS84(v)
+	}
+	return Geofence{numVerts: len(verts), verts: verts}
+}
+
+// NewGeoPolygon builds a GeoPolygon from raw coordinate pairs expressed in
+// p's native SRID, in the same [lon, lat] ordering ParseGeoJSON decodes,
+// and reprojects them into the WGS84 radians H3 requires via
+// GeoPolygon.Reproject(p).
+// exterior is the exterior ring; holes, if any, are interior rings. Each
+// ring may optionally repeat its first position as its last, GeoJSON-style;
+// the duplicate is dropped.
+//
+// This is the construction path Reproject was otherwise unreachable
+// through: ParseGeoJSON always assumes WGS84 degree input, so a polygon
+// given in, say, raw EPSG:3857 meters has to be built here instead.
+func NewGeoPolygon(exterior [][2]float64, holes [][][2]float64, p Projector) *GeoPolygon {
+	raw := &GeoPolygon{geofence: rawGeofence(exterior)}
+
+	if len(holes) > 0 {
+		raw.numHoles = len(holes)
+		raw.holes = make([]Geofence, len(holes))
+		for i, h := range holes {
+			raw.holes[i] = rawGeofence(h)
+		}
+	}
+
+	return raw.Reproject(p)
+}
+
+// rawGeofence packages a ring of raw [lon, lat] pairs into a Geofence with
+// no unit conversion, dropping a GeoJSON-style duplicated closing position
+// if present. The resulting Geofence's verts are not yet WGS84 radians;
+// callers reach that via Reproject (NewGeoPolygon does this for them).
+func rawGeofence(ring [][2]float64) Geofence {
+	if len(ring) > 1 && ring[0] == ring[len(ring)-1] {
+		ring = ring[:len(ring)-1]
+	}
+
+	verts := make([]geoCoord, len(ring))
+	for i, pt := range ring {
+		verts[i] = geoCoord{Lon: pt[0], Lat: pt[1]}
+	}
+	return Geofence{numVerts: len(verts), verts: verts}
+}
@@ -0,0 +1,67 @@
+package h3
+
+import (
+	"math"
+	"testing"
+)
+
+func TestWebMercatorRoundTrip(t *testing.T) {
+	original := geo(-0.1278, 51.5074) // London, WGS84 radians
+
+	meters := WebMercator.FromWGS84(original)
+	back := WebMercator.ToWGS84(meters)
+
+	if math.Abs(back.Lon-original.Lon) > 1e-9 || math.Abs(back.Lat-original.Lat) > 1e-9 {
+		t.Fatalf("round trip mismatch: got %+v, want %+v", back, original)
+	}
+}
+
+func TestWGS84ProjectorConvertsDegreesToRadians(t *testing.T) {
+	deg := geoCoord{Lon: 10, Lat: 20}
+
+	rad := WGS84.ToWGS84(deg)
+	if want := *deg.AsRadians(); rad != want {
+		t.Fatalf("ToWGS84(%+v) = %+v, want %+v", deg, rad, want)
+	}
+
+	back := WGS84.FromWGS84(rad)
+	if math.Abs(back.Lon-10) > 1e-9 || math.Abs(back.Lat-20) > 1e-9 {
+		t.Fatalf("round trip back to degrees mismatch: got %+v", back)
+	}
+}
+
+func TestNewGeoPolygonReprojectsRawCoordinates(t *testing.T) {
+	corner := geo(-0.1, 51.5) // the WGS84 radians we expect to recover
+	m := WebMercator.FromWGS84(corner)
+
+	exteriorMeters := [][2]float64{
+		{m.Lon, m.Lat},
+		{m.Lon + 1, m.Lat},
+		{m.Lon + 1, m.Lat + 1},
+		{m.Lon, m.Lat + 1},
+		{m.Lon, m.Lat}, // GeoJSON-style closed ring
+	}
+
+	gp := NewGeoPolygon(exteriorMeters, nil, WebMercator)
+
+	if got := len(gp.geofence.verts); got != 4 {
+		t.Fatalf("expected closing vertex dropped, got %d verts", got)
+	}
+
+	got := gp.geofence.verts[0]
+	if math.Abs(got.Lon-corner.Lon) > 1e-6 || math.Abs(got.Lat-corner.Lat) > 1e-6 {
+		t.Fatalf("expected first vertex reprojected back to ~%+v, got %+v", corner, got)
+	}
+}
+
+func TestNewGeoPolygonWithHoles(t *testing.T) {
+	gp := NewGeoPolygon(
+		[][2]float64{{-1, -1}, {1, -1}, {1, 1}, {-1, 1}, {-1, -1}},
+		[][][2]float64{{{-0.2, -0.2}, {0.2, -0.2}, {0.2, 0.2}, {-0.2, 0.2}, {-0.2, -0.2}}},
+		WGS84,
+	)
+
+	if gp.numHoles != 1 || len(gp.holes[0].verts) != 4 {
+		t.Fatalf("expected 1 hole with 4 verts, got numHoles=%d", gp.numHoles)
+	}
+}
@@ -0,0 +1,24 @@
+package h3
+
+// geo builds a geoCoord from degrees, matching how GeoFromDegrees treats
+// its arguments.
+func geo(lonDeg, latDeg float64) geoCoord {
+	return *(&geoCoord{Lon: lonDeg, Lat: latDeg}).AsRadians()
+}
+
+// squareGeofence builds a CCW exterior ring for the axis-aligned box
+// [minLon, maxLon] x [minLat, maxLat], in degrees.
+func squareGeofence(minLon, minLat, maxLon, maxLat float64) Geofence {
+	corners := [][2]float64{
+		{minLon, minLat},
+		{maxLon, minLat},
+		{maxLon, maxLat},
+		{minLon, maxLat},
+	}
+
+	verts := make([]geoCoord, len(corners))
+	for i, c := range corners {
+		verts[i] = geo(c[0], c[1])
+	}
+	return Geofence{numVerts: len(verts), verts: verts}
+}